@@ -0,0 +1,44 @@
+package jsonptr
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Delete removes the member or element addressed by ptr: a member is removed from its Obj, an element is
+// spliced out of its Arr, shifting later elements down. Deleting the whole-document pointer "" is refused.
+func Delete(objOrArr ObjOrArr, ptr Ptr) error {
+	parentPtr, last, ok := PtrParent(ptr)
+	if !ok {
+		return fmt.Errorf("cannot delete on empty JSON pointer")
+	}
+
+	parentVal, err := Eval(objOrArr, parentPtr)
+	if err != nil {
+		return err
+	}
+
+	switch parent := parentVal.(type) {
+	case *Obj:
+		if _, ok := parent.Get(last); !ok {
+			return fmt.Errorf("key '%s' not found", last)
+		}
+
+		parent.Delete(last)
+		return nil
+	case *Arr:
+		idx, err := strconv.Atoi(last)
+		if err != nil {
+			return fmt.Errorf("expected integer index, got '%s'", last)
+		}
+
+		if idx < 0 || idx >= parent.Len() {
+			return fmt.Errorf("index out of bounds: %d", idx)
+		}
+
+		parent.RemoveAt(idx)
+		return nil
+	default:
+		return fmt.Errorf("key '%s' not addressable", last)
+	}
+}
@@ -0,0 +1,78 @@
+package jsonptr
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDelete(t *testing.T) {
+	obj := &Obj{}
+	if err := json.Unmarshal([]byte(`{"a":1,"b":["x","y","z"]}`), &obj); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Delete(obj, "/a"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Eval(obj, "/a"); err == nil {
+		t.Fatal("expected /a to be gone")
+	}
+
+	if err := Delete(obj, "/b/1"); err != nil {
+		t.Fatal(err)
+	}
+
+	if v := MustEval(obj, "/b/0").String(); v != "x" {
+		t.Fatalf("expected x, got %s", v)
+	}
+
+	if v := MustEval(obj, "/b/1").String(); v != "z" {
+		t.Fatalf("expected z, got %s", v)
+	}
+
+	if err := Delete(obj, ""); err == nil {
+		t.Fatal("expected deleting the whole document to be refused")
+	}
+
+	if err := Delete(obj, "/missing"); err == nil {
+		t.Fatal("expected an error deleting a missing key")
+	}
+}
+
+func TestEvalTyped(t *testing.T) {
+	obj := &Obj{}
+	if err := json.Unmarshal([]byte(testJSON2), &obj); err != nil {
+		t.Fatal(err)
+	}
+
+	if s, found, err := EvalString(obj, "/details/name"); err != nil || !found || s != "hello" {
+		t.Fatalf("expected hello, got %q found=%v err=%v", s, found, err)
+	}
+
+	if n, found, err := EvalFloat64(obj, "/details/id"); err != nil || !found || n != 123 {
+		t.Fatalf("expected 123, got %v found=%v err=%v", n, found, err)
+	}
+
+	if b, found, err := EvalBool(obj, "/details/flag"); err != nil || !found || !b {
+		t.Fatalf("expected true, got %v found=%v err=%v", b, found, err)
+	}
+
+	if a, found, err := EvalArr(obj, "/details/nested/list"); err != nil || !found || a.Len() != 3 {
+		t.Fatalf("expected array of len 3, got %v found=%v err=%v", a, found, err)
+	}
+
+	if o, found, err := EvalObj(obj, "/details/nested"); err != nil || !found || o.Len() != 3 {
+		t.Fatalf("expected object of len 3, got %v found=%v err=%v", o, found, err)
+	}
+
+	// resolved, but wrong type: found is false, err is nil
+	if _, found, err := EvalString(obj, "/details/id"); err != nil || found {
+		t.Fatalf("expected found=false, err=nil, got found=%v err=%v", found, err)
+	}
+
+	// did not resolve at all: err is non-nil
+	if _, found, err := EvalString(obj, "/details/missing"); err == nil || found {
+		t.Fatalf("expected an error, got found=%v err=%v", found, err)
+	}
+}
@@ -6,7 +6,6 @@ package jsonptr
 
 import (
 	"fmt"
-	"sort"
 	"strconv"
 	"strings"
 )
@@ -40,12 +39,12 @@ func Eval(objOrArr ObjOrArr, ptr Ptr) (Value, error) {
 			return nil, fmt.Errorf("key '%s' not found:\n%s", token, evalMsg(tIdx, tokens, nil))
 		}
 		switch t := root.(type) {
-		case Obj:
-			if val, ok := t[token]; ok {
+		case *Obj:
+			if val, ok := t.Get(token); ok {
 				root = val
 			} else {
 				root = nil
-				return nil, fmt.Errorf("key '%s' not found:\n%s", token, evalMsg(tIdx, tokens, keysAsSlice(t)))
+				return nil, fmt.Errorf("key '%s' not found:\n%s", token, evalMsg(tIdx, tokens, t.Keys()))
 			}
 
 		case *Arr:
@@ -75,15 +74,6 @@ func MustEval(objOrArr ObjOrArr, ptr Ptr) Value {
 	return v
 }
 
-func keysAsSlice[T any](m map[string]T) []string {
-	res := make([]string, len(m))[0:0]
-	for k := range m {
-		res = append(res, k)
-	}
-	sort.Strings(res)
-	return res
-}
-
 func baseMsg(failedAt int, tokens []PtrToken) *strings.Builder {
 	tmp := &strings.Builder{}
 	sb := &strings.Builder{}
@@ -0,0 +1,131 @@
+package jsonptr
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestObjPreservesInsertionOrder(t *testing.T) {
+	const src = `{"z":1,"a":2,"m":3}`
+
+	obj := &Obj{}
+	if err := json.Unmarshal([]byte(src), &obj); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"z", "a", "m"}
+	if got := obj.Keys(); !equalStrings(got, want) {
+		t.Fatalf("expected keys %v, got %v", want, got)
+	}
+
+	obj.Put("b", Number(4))
+	want = append(want, "b")
+	if got := obj.Keys(); !equalStrings(got, want) {
+		t.Fatalf("expected keys %v after Put, got %v", want, got)
+	}
+
+	// re-putting an existing key must not change its position
+	obj.Put("a", Number(42))
+	if got := obj.Keys(); !equalStrings(got, want) {
+		t.Fatalf("expected keys %v after re-Put, got %v", want, got)
+	}
+
+	if v, _ := obj.Get("a"); v.Float64() != 42 {
+		t.Fatalf("expected 42, got %v", v)
+	}
+
+	buf, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(buf) != `{"z":1,"a":42,"m":3,"b":4}` {
+		t.Fatalf("unexpected marshaled order: %s", buf)
+	}
+}
+
+func TestObjPreservesNestedInsertionOrder(t *testing.T) {
+	const src = `{"z":1,"a":2,"m":{"y":9,"b":8,"c":7}}`
+
+	for i := 0; i < 20; i++ {
+		obj := &Obj{}
+		if err := json.Unmarshal([]byte(src), &obj); err != nil {
+			t.Fatal(err)
+		}
+
+		nested, ok := obj.Get("m")
+		if !ok {
+			t.Fatal("expected member 'm'")
+		}
+
+		if got, want := nested.(*Obj).Keys(), []string{"y", "b", "c"}; !equalStrings(got, want) {
+			t.Fatalf("expected nested keys %v, got %v", want, got)
+		}
+
+		buf, err := json.Marshal(obj)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(buf) != src {
+			t.Fatalf("expected round-trip %s, got %s", src, buf)
+		}
+	}
+}
+
+func TestObjDelete(t *testing.T) {
+	obj := &Obj{}
+	if err := json.Unmarshal([]byte(`{"a":1,"b":2,"c":3}`), &obj); err != nil {
+		t.Fatal(err)
+	}
+
+	obj.Delete("b")
+
+	if obj.Len() != 2 {
+		t.Fatalf("expected 2 members, got %d", obj.Len())
+	}
+
+	if _, ok := obj.Get("b"); ok {
+		t.Fatal("expected b to be deleted")
+	}
+
+	if got, want := obj.Keys(), []string{"a", "c"}; !equalStrings(got, want) {
+		t.Fatalf("expected keys %v, got %v", want, got)
+	}
+
+	obj.Put("d", Number(4))
+	if v, ok := obj.Get("d"); !ok || v.Float64() != 4 {
+		t.Fatal("expected index bookkeeping to survive a prior Delete")
+	}
+}
+
+func TestObjRange(t *testing.T) {
+	obj := &Obj{}
+	if err := json.Unmarshal([]byte(`{"a":1,"b":2,"c":3}`), &obj); err != nil {
+		t.Fatal(err)
+	}
+
+	var seen []string
+	obj.Range(func(key string, val Value) bool {
+		seen = append(seen, key)
+		return key != "b"
+	})
+
+	if want := []string{"a", "b"}; !equalStrings(seen, want) {
+		t.Fatalf("expected Range to stop early at %v, saw %v", want, seen)
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
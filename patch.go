@@ -0,0 +1,460 @@
+package jsonptr
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// OpType identifies one of the six RFC 6902 JSON Patch operations.
+type OpType string
+
+const (
+	OpAdd     OpType = "add"
+	OpRemove  OpType = "remove"
+	OpReplace OpType = "replace"
+	OpMove    OpType = "move"
+	OpCopy    OpType = "copy"
+	OpTest    OpType = "test"
+)
+
+// A PatchOp is a single RFC 6902 JSON Patch operation.
+type PatchOp struct {
+	Op    OpType `json:"op"`
+	Path  Ptr    `json:"path"`
+	From  Ptr    `json:"from,omitempty"`
+	Value Value  `json:"value,omitempty"`
+}
+
+// UnmarshalJSON decodes a PatchOp, mapping its value member onto the Value tree via ValueOf.
+func (p *PatchOp) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Op    OpType          `json:"op"`
+		Path  Ptr             `json:"path"`
+		From  Ptr             `json:"from,omitempty"`
+		Value json.RawMessage `json:"value,omitempty"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	p.Op = raw.Op
+	p.Path = raw.Path
+	p.From = raw.From
+	p.Value = nil
+
+	if len(raw.Value) > 0 {
+		var v any
+		if err := json.Unmarshal(raw.Value, &v); err != nil {
+			return err
+		}
+
+		p.Value = ValueOf(v)
+	}
+
+	return nil
+}
+
+// A Patch is an ordered list of RFC 6902 JSON Patch operations.
+// See https://tools.ietf.org/html/rfc6902 for the specification.
+type Patch []PatchOp
+
+// MarshalJSON encodes the patch as a JSON array of operations.
+func (p Patch) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]PatchOp(p))
+}
+
+// UnmarshalJSON decodes the patch from a JSON array of operations.
+func (p *Patch) UnmarshalJSON(data []byte) error {
+	var ops []PatchOp
+	if err := json.Unmarshal(data, &ops); err != nil {
+		return err
+	}
+
+	*p = ops
+	return nil
+}
+
+// Apply executes patch against objOrArr and returns the resulting document. objOrArr itself is never mutated:
+// Apply deep copies it upfront via cloneValue and, if any operation fails, discards the copy and returns the
+// error, leaving the caller's original document untouched.
+func Apply(objOrArr ObjOrArr, patch Patch) (ObjOrArr, error) {
+	if objOrArr == nil {
+		return nil, fmt.Errorf("jsonptr: cannot patch a nil document")
+	}
+
+	cloned := cloneValue(objOrArr)
+	working, ok := cloned.(ObjOrArr)
+	if !ok {
+		return nil, fmt.Errorf("jsonptr: patch target is not an object or array")
+	}
+
+	for i, op := range patch {
+		var err error
+		switch op.Op {
+		case OpAdd:
+			err = opAdd(&working, op.Path, op.Value)
+		case OpRemove:
+			_, err = opRemove(&working, op.Path)
+		case OpReplace:
+			err = opReplace(&working, op.Path, op.Value)
+		case OpMove:
+			err = opMove(&working, op.From, op.Path)
+		case OpCopy:
+			err = opCopy(&working, op.From, op.Path)
+		case OpTest:
+			err = opTest(&working, op.Path, op.Value)
+		default:
+			err = fmt.Errorf("unknown operation %q", op.Op)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("jsonptr: patch operation %d (%s %s): %w", i, op.Op, op.Path, err)
+		}
+	}
+
+	return working, nil
+}
+
+// Diff produces a Patch that, applied to a, yields b. It is not guaranteed to be byte-minimal, but avoids emitting
+// operations for subtrees that are already equal.
+func Diff(a, b ObjOrArr) Patch {
+	var patch Patch
+	diffValue("", a, b, &patch)
+	return patch
+}
+
+func diffValue(path Ptr, a, b Value, patch *Patch) {
+	switch bt := b.(type) {
+	case *Obj:
+		at, ok := a.(*Obj)
+		if !ok {
+			*patch = append(*patch, PatchOp{Op: OpReplace, Path: path, Value: cloneValue(b)})
+			return
+		}
+
+		for _, k := range at.Keys() {
+			if _, ok := bt.Get(k); !ok {
+				*patch = append(*patch, PatchOp{Op: OpRemove, Path: path + "/" + Escape(k)})
+			}
+		}
+
+		bt.Range(func(k string, bval Value) bool {
+			if aval, ok := at.Get(k); ok {
+				diffValue(path+"/"+Escape(k), aval, bval, patch)
+			} else {
+				*patch = append(*patch, PatchOp{Op: OpAdd, Path: path + "/" + Escape(k), Value: cloneValue(bval)})
+			}
+
+			return true
+		})
+	case *Arr:
+		at, ok := a.(*Arr)
+		if !ok || at.Len() != bt.Len() {
+			*patch = append(*patch, PatchOp{Op: OpReplace, Path: path, Value: cloneValue(b)})
+			return
+		}
+
+		for i := 0; i < bt.Len(); i++ {
+			diffValue(fmt.Sprintf("%s/%d", path, i), at.Get(i), bt.Get(i), patch)
+		}
+	default:
+		if !deepEqualValue(a, b) {
+			*patch = append(*patch, PatchOp{Op: OpReplace, Path: path, Value: cloneValue(b)})
+		}
+	}
+}
+
+func opAdd(doc *ObjOrArr, path Ptr, value Value) error {
+	if path == "" {
+		container, ok := value.(ObjOrArr)
+		if !ok {
+			return fmt.Errorf("value of type %T cannot replace the document root", value)
+		}
+
+		*doc = container
+		return nil
+	}
+
+	parentPtr, last, err := splitPath(path)
+	if err != nil {
+		return err
+	}
+
+	parentVal, err := Eval(*doc, parentPtr)
+	if err != nil {
+		return err
+	}
+
+	switch parent := parentVal.(type) {
+	case *Obj:
+		parent.Put(last, value)
+		return nil
+	case *Arr:
+		idx, err := arrInsertIndex(parent, last)
+		if err != nil {
+			return err
+		}
+
+		parent.Append(Null{})
+		for i := parent.Len() - 1; i > idx; i-- {
+			parent.SetAt(i, parent.Get(i-1))
+		}
+
+		parent.SetAt(idx, value)
+		return nil
+	default:
+		return fmt.Errorf("path %q does not address an object or array", parentPtr)
+	}
+}
+
+func opRemove(doc *ObjOrArr, path Ptr) (Value, error) {
+	if path == "" {
+		return nil, fmt.Errorf("cannot remove the whole document")
+	}
+
+	parentPtr, last, err := splitPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	parentVal, err := Eval(*doc, parentPtr)
+	if err != nil {
+		return nil, err
+	}
+
+	switch parent := parentVal.(type) {
+	case *Obj:
+		old, ok := parent.Get(last)
+		if !ok {
+			return nil, fmt.Errorf("key %q not found", last)
+		}
+
+		parent.Delete(last)
+		return old, nil
+	case *Arr:
+		idx, err := arrIndex(parent, last)
+		if err != nil {
+			return nil, err
+		}
+
+		return parent.RemoveAt(idx), nil
+	default:
+		return nil, fmt.Errorf("path %q does not address an object or array", parentPtr)
+	}
+}
+
+func opReplace(doc *ObjOrArr, path Ptr, value Value) error {
+	if path == "" {
+		container, ok := value.(ObjOrArr)
+		if !ok {
+			return fmt.Errorf("value of type %T cannot replace the document root", value)
+		}
+
+		*doc = container
+		return nil
+	}
+
+	parentPtr, last, err := splitPath(path)
+	if err != nil {
+		return err
+	}
+
+	parentVal, err := Eval(*doc, parentPtr)
+	if err != nil {
+		return err
+	}
+
+	switch parent := parentVal.(type) {
+	case *Obj:
+		if _, ok := parent.Get(last); !ok {
+			return fmt.Errorf("key %q not found", last)
+		}
+
+		parent.Put(last, value)
+		return nil
+	case *Arr:
+		idx, err := arrIndex(parent, last)
+		if err != nil {
+			return err
+		}
+
+		parent.SetAt(idx, value)
+		return nil
+	default:
+		return fmt.Errorf("path %q does not address an object or array", parentPtr)
+	}
+}
+
+func opMove(doc *ObjOrArr, from, path Ptr) error {
+	if pathHasPrefix(path, from) {
+		return fmt.Errorf("'from' %q is a prefix of 'path' %q", from, path)
+	}
+
+	val, err := opRemove(doc, from)
+	if err != nil {
+		return err
+	}
+
+	return opAdd(doc, path, val)
+}
+
+func opCopy(doc *ObjOrArr, from, path Ptr) error {
+	val, err := Eval(*doc, from)
+	if err != nil {
+		return err
+	}
+
+	return opAdd(doc, path, cloneValue(val))
+}
+
+func opTest(doc *ObjOrArr, path Ptr, expected Value) error {
+	actual, err := Eval(*doc, path)
+	if err != nil {
+		return err
+	}
+
+	if !deepEqualValue(actual, expected) {
+		return fmt.Errorf("test failed at %q", path)
+	}
+
+	return nil
+}
+
+// splitPath splits ptr into its parent pointer and its last, unescaped token.
+func splitPath(ptr Ptr) (parent Ptr, last PtrToken, err error) {
+	parent, last, ok := PtrParent(ptr)
+	if !ok {
+		return "", "", fmt.Errorf("cannot split empty json pointer")
+	}
+
+	return parent, last, nil
+}
+
+// pathHasPrefix reports whether prefix addresses path itself or an ancestor of path.
+func pathHasPrefix(path, prefix Ptr) bool {
+	if path == prefix {
+		return true
+	}
+
+	return strings.HasPrefix(path, prefix+"/")
+}
+
+// arrIndex resolves token to an existing index of arr.
+func arrIndex(arr *Arr, token PtrToken) (int, error) {
+	idx, err := strconv.Atoi(token)
+	if err != nil {
+		return 0, fmt.Errorf("expected integer array index, got %q", token)
+	}
+
+	if idx < 0 || idx >= arr.Len() {
+		return 0, fmt.Errorf("index %d out of bounds [0...%d[", idx, arr.Len())
+	}
+
+	return idx, nil
+}
+
+// arrInsertIndex resolves token to an insertion position of arr, also accepting the "-" append token and the
+// one-past-the-end index.
+func arrInsertIndex(arr *Arr, token PtrToken) (int, error) {
+	if token == "-" {
+		return arr.Len(), nil
+	}
+
+	idx, err := strconv.Atoi(token)
+	if err != nil {
+		return 0, fmt.Errorf("expected integer array index, got %q", token)
+	}
+
+	if idx < 0 || idx > arr.Len() {
+		return 0, fmt.Errorf("index %d out of bounds [0...%d]", idx, arr.Len())
+	}
+
+	return idx, nil
+}
+
+// cloneValue returns a deep copy of v, detached from any Obj/Arr it may currently belong to. Nested Obj members
+// keep their original order, matching Obj's own order-preserving semantics.
+func cloneValue(v Value) Value {
+	switch t := v.(type) {
+	case *Obj:
+		if t == nil {
+			return (*Obj)(nil)
+		}
+
+		clone := &Obj{}
+		t.Range(func(k string, val Value) bool {
+			clone.Put(k, cloneValue(val))
+			return true
+		})
+
+		return clone
+	case *Arr:
+		if t == nil {
+			return (*Arr)(nil)
+		}
+
+		clone := &Arr{}
+		for i := 0; i < t.Len(); i++ {
+			clone.Append(cloneValue(t.Get(i)))
+		}
+
+		return clone
+	default:
+		return t
+	}
+}
+
+// deepEqualValue reports whether a and b are structurally equal: numbers compared as float64, arrays
+// element-wise, objects key-set equal.
+func deepEqualValue(a, b Value) bool {
+	switch at := a.(type) {
+	case Null:
+		_, ok := b.(Null)
+		return ok
+	case Number:
+		bt, ok := b.(Number)
+		return ok && float64(at) == float64(bt)
+	case Bool:
+		bt, ok := b.(Bool)
+		return ok && at == bt
+	case String:
+		bt, ok := b.(String)
+		return ok && at == bt
+	case *Arr:
+		bt, ok := b.(*Arr)
+		if !ok || at.Len() != bt.Len() {
+			return false
+		}
+
+		for i := 0; i < at.Len(); i++ {
+			if !deepEqualValue(at.Get(i), bt.Get(i)) {
+				return false
+			}
+		}
+
+		return true
+	case *Obj:
+		bt, ok := b.(*Obj)
+		if !ok || at.Len() != bt.Len() {
+			return false
+		}
+
+		equal := true
+		at.Range(func(k string, v Value) bool {
+			bval, ok := bt.Get(k)
+			if !ok || !deepEqualValue(v, bval) {
+				equal = false
+				return false
+			}
+
+			return true
+		})
+
+		return equal
+	default:
+		return false
+	}
+}
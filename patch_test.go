@@ -0,0 +1,254 @@
+package jsonptr
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestApplyAdd(t *testing.T) {
+	obj := &Obj{}
+	if err := json.Unmarshal([]byte(`{"a":{"b":["x","y"]}}`), &obj); err != nil {
+		t.Fatal(err)
+	}
+
+	patch := Patch{
+		{Op: OpAdd, Path: "/a/c", Value: String("new")},
+		{Op: OpAdd, Path: "/a/b/-", Value: String("z")},
+		{Op: OpAdd, Path: "/a/b/1", Value: String("inserted")},
+	}
+
+	res, err := Apply(obj, patch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v := MustEval(res, "/a/c").String(); v != "new" {
+		t.Fatalf("expected %q, got %q", "new", v)
+	}
+
+	if v := MustEval(res, "/a/b/1").String(); v != "inserted" {
+		t.Fatalf("expected %q, got %q", "inserted", v)
+	}
+
+	if v := MustEval(res, "/a/b/3").String(); v != "z" {
+		t.Fatalf("expected %q, got %q", "z", v)
+	}
+
+	// the original document must be untouched
+	if _, err := Eval(obj, "/a/c"); err == nil {
+		t.Fatal("expected original document to be unmodified")
+	}
+}
+
+func TestApplyRemove(t *testing.T) {
+	obj := &Obj{}
+	if err := json.Unmarshal([]byte(`{"a":1,"b":["x","y","z"]}`), &obj); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := Apply(obj, Patch{
+		{Op: OpRemove, Path: "/a"},
+		{Op: OpRemove, Path: "/b/1"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Eval(res, "/a"); err == nil {
+		t.Fatal("expected /a to be removed")
+	}
+
+	if v := MustEval(res, "/b/0").String(); v != "x" {
+		t.Fatalf("expected %q, got %q", "x", v)
+	}
+
+	if v := MustEval(res, "/b/1").String(); v != "z" {
+		t.Fatalf("expected %q, got %q", "z", v)
+	}
+}
+
+func TestApplyReplace(t *testing.T) {
+	obj := &Obj{}
+	if err := json.Unmarshal([]byte(`{"a":1}`), &obj); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := Apply(obj, Patch{{Op: OpReplace, Path: "/a", Value: Number(42)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v := MustEval(res, "/a").Float64(); v != 42 {
+		t.Fatalf("expected 42, got %f", v)
+	}
+
+	if _, err := Apply(obj, Patch{{Op: OpReplace, Path: "/missing", Value: Number(1)}}); err == nil {
+		t.Fatal("expected error replacing a missing member")
+	}
+}
+
+func TestApplyMove(t *testing.T) {
+	obj := &Obj{}
+	if err := json.Unmarshal([]byte(`{"a":{"b":1},"c":2}`), &obj); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := Apply(obj, Patch{{Op: OpMove, From: "/a/b", Path: "/c"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v := MustEval(res, "/c").Float64(); v != 1 {
+		t.Fatalf("expected 1, got %f", v)
+	}
+
+	if _, err := Eval(res, "/a/b"); err == nil {
+		t.Fatal("expected /a/b to be gone after move")
+	}
+
+	if _, err := Apply(obj, Patch{{Op: OpMove, From: "/a", Path: "/a/b"}}); err == nil {
+		t.Fatal("expected error moving a path onto its own descendant")
+	}
+}
+
+func TestApplyCopy(t *testing.T) {
+	obj := &Obj{}
+	if err := json.Unmarshal([]byte(`{"a":{"b":1}}`), &obj); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := Apply(obj, Patch{{Op: OpCopy, From: "/a", Path: "/a2"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v := MustEval(res, "/a2/b").Float64(); v != 1 {
+		t.Fatalf("expected 1, got %f", v)
+	}
+
+	if err := Put(res, "/a2/b", Number(2)); err != nil {
+		t.Fatal(err)
+	}
+
+	if v := MustEval(res, "/a/b").Float64(); v != 1 {
+		t.Fatal("expected copy to be independent of its source")
+	}
+}
+
+func TestApplyTest(t *testing.T) {
+	obj := &Obj{}
+	if err := json.Unmarshal([]byte(`{"a":1,"b":[1,2,3]}`), &obj); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Apply(obj, Patch{{Op: OpTest, Path: "/a", Value: Number(1)}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Apply(obj, Patch{{Op: OpTest, Path: "/b", Value: NewArr(Number(1), Number(2), Number(3))}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Apply(obj, Patch{{Op: OpTest, Path: "/a", Value: Number(2)}}); err == nil {
+		t.Fatal("expected test to fail")
+	}
+}
+
+func TestApplyAtomic(t *testing.T) {
+	obj := &Obj{}
+	if err := json.Unmarshal([]byte(`{"a":1}`), &obj); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := Apply(obj, Patch{
+		{Op: OpAdd, Path: "/b", Value: Number(2)},
+		{Op: OpRemove, Path: "/missing"},
+	})
+	if err == nil {
+		t.Fatal("expected the patch to fail")
+	}
+
+	if _, err := Eval(obj, "/b"); err == nil {
+		t.Fatal("expected original document to be unaffected by a failed patch")
+	}
+}
+
+func TestApplyPreservesKeyOrder(t *testing.T) {
+	obj := &Obj{}
+	if err := json.Unmarshal([]byte(`{"z":1,"a":2,"m":3,"k":4}`), &obj); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := Apply(obj, Patch{
+		{Op: OpReplace, Path: "/a", Value: Number(42)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := res.(*Obj).Keys(), []string{"z", "a", "m", "k"}; !equalStrings(got, want) {
+		t.Fatalf("expected keys %v, got %v", want, got)
+	}
+}
+
+func TestPatchJSONRoundtrip(t *testing.T) {
+	const raw = `[{"op":"add","path":"/a","value":1},{"op":"remove","path":"/b"},{"op":"move","from":"/c","path":"/d"}]`
+
+	var patch Patch
+	if err := json.Unmarshal([]byte(raw), &patch); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(patch) != 3 {
+		t.Fatalf("expected 3 operations, got %d", len(patch))
+	}
+
+	if patch[0].Value.Float64() != 1 {
+		t.Fatalf("expected 1, got %v", patch[0].Value)
+	}
+
+	buf, err := json.Marshal(patch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var roundtripped Patch
+	if err := json.Unmarshal(buf, &roundtripped); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(roundtripped) != len(patch) {
+		t.Fatalf("expected %d operations, got %d", len(patch), len(roundtripped))
+	}
+}
+
+func TestDiff(t *testing.T) {
+	a := &Obj{}
+	if err := json.Unmarshal([]byte(`{"x":1,"y":2}`), &a); err != nil {
+		t.Fatal(err)
+	}
+
+	b := &Obj{}
+	if err := json.Unmarshal([]byte(`{"x":1,"z":3}`), &b); err != nil {
+		t.Fatal(err)
+	}
+
+	patch := Diff(a, b)
+
+	res, err := Apply(a, patch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Eval(res, "/y"); err == nil {
+		t.Fatal("expected /y to be removed")
+	}
+
+	if v := MustEval(res, "/z").Float64(); v != 3 {
+		t.Fatalf("expected 3, got %f", v)
+	}
+
+	if v := MustEval(res, "/x").Float64(); v != 1 {
+		t.Fatalf("expected 1, got %f", v)
+	}
+}
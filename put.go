@@ -77,7 +77,7 @@ func Put(objOrArr ObjOrArr, ptr Ptr, value Value) error {
 					if idx < 0 {
 						return fmt.Errorf("index out of bounds:\n%s", evalMsgArr(tIdx, tokens, 0, v.Len()))
 					} else {
-						for range int(idx+1) - v.Len() {
+						for i := int(idx+1) - v.Len(); i > 0; i-- {
 							v.Append(Null{})
 						}
 
@@ -98,7 +98,7 @@ func Put(objOrArr ObjOrArr, ptr Ptr, value Value) error {
 			if idx < 0 {
 				v.Append(value)
 			} else {
-				for range int(idx+1) - v.Len() {
+				for i := int(idx+1) - v.Len(); i > 0; i-- {
 					v.Append(Null{})
 				}
 
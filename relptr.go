@@ -0,0 +1,175 @@
+package jsonptr
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PtrParent splits ptr into its parent pointer and its last, unescaped token. ok is false if ptr is the
+// whole-document pointer "" and therefore has no parent.
+func PtrParent(ptr Ptr) (Ptr, PtrToken, bool) {
+	if len(ptr) == 0 {
+		return "", "", false
+	}
+
+	idx := strings.LastIndex(ptr, "/")
+	if idx < 0 {
+		return "", "", false
+	}
+
+	return ptr[:idx], Unescape(ptr[idx+1:]), true
+}
+
+// PtrJoin appends tokens to parent, escaping each of them, and returns the resulting pointer.
+func PtrJoin(parent Ptr, tokens ...PtrToken) Ptr {
+	var sb strings.Builder
+	sb.WriteString(parent)
+	for _, t := range tokens {
+		sb.WriteString("/")
+		sb.WriteString(Escape(t))
+	}
+
+	return sb.String()
+}
+
+// EvalRelative resolves relPtr, a draft-bhutton Relative JSON Pointer, against root. current is the value already
+// located at currentPtr and is used as a shortcut when relPtr neither walks up nor adjusts an array index.
+//
+// A Relative JSON Pointer starts with a non-negative integer N, the number of levels to walk up from currentPtr,
+// optionally followed by "+M" or "-M" to adjust the resulting array-index token, and finally either a normal
+// "/..." pointer suffix or a lone "#" that returns the key or index which referenced the resolved node.
+func EvalRelative(root, current ObjOrArr, currentPtr Ptr, relPtr string) (Value, error) {
+	levels, rest, err := parseRelLevels(relPtr)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := ptrTokens(currentPtr)
+	if levels > len(tokens) {
+		return nil, fmt.Errorf("jsonptr: relative pointer %q walks %d level(s) above the root %q", relPtr, levels, currentPtr)
+	}
+
+	tokens = tokens[:len(tokens)-levels]
+
+	adjust, hasAdjust, rest, err := parseRelAdjust(rest)
+	if err != nil {
+		return nil, err
+	}
+
+	if hasAdjust {
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("jsonptr: relative pointer %q has no array index to adjust", relPtr)
+		}
+
+		parentVal, err := Eval(root, PtrJoin("", tokens[:len(tokens)-1]...))
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := parentVal.(*Arr); !ok {
+			return nil, fmt.Errorf("jsonptr: relative pointer %q applies +/- to a non-array parent", relPtr)
+		}
+
+		idx, err := strconv.Atoi(tokens[len(tokens)-1])
+		if err != nil {
+			return nil, fmt.Errorf("jsonptr: relative pointer %q applies +/- to non-numeric token %q", relPtr, tokens[len(tokens)-1])
+		}
+
+		tokens[len(tokens)-1] = strconv.Itoa(idx + adjust)
+	}
+
+	if rest == "#" {
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("jsonptr: relative pointer %q has no parent to reference", relPtr)
+		}
+
+		parentVal, err := Eval(root, PtrJoin("", tokens[:len(tokens)-1]...))
+		if err != nil {
+			return nil, err
+		}
+
+		last := tokens[len(tokens)-1]
+		if _, ok := parentVal.(*Arr); ok {
+			idx, err := strconv.Atoi(last)
+			if err != nil {
+				return nil, fmt.Errorf("jsonptr: non-numeric array index token %q", last)
+			}
+
+			return Number(idx), nil
+		}
+
+		return String(last), nil
+	}
+
+	if len(rest) > 0 && rest[0] != '/' {
+		return nil, fmt.Errorf("jsonptr: invalid relative pointer suffix %q", rest)
+	}
+
+	if levels == 0 && !hasAdjust {
+		if rest == "" {
+			return current, nil
+		}
+
+		return Eval(current, rest)
+	}
+
+	return Eval(root, PtrJoin("", tokens...)+rest)
+}
+
+// parseRelLevels consumes the leading non-negative integer of a Relative JSON Pointer.
+func parseRelLevels(relPtr string) (int, string, error) {
+	i := 0
+	for i < len(relPtr) && relPtr[i] >= '0' && relPtr[i] <= '9' {
+		i++
+	}
+
+	if i == 0 {
+		return 0, "", fmt.Errorf("jsonptr: relative pointer %q must start with a non-negative integer", relPtr)
+	}
+
+	n, err := strconv.Atoi(relPtr[:i])
+	if err != nil {
+		return 0, "", fmt.Errorf("jsonptr: invalid relative pointer level in %q: %w", relPtr, err)
+	}
+
+	return n, relPtr[i:], nil
+}
+
+// parseRelAdjust consumes an optional leading "+M"/"-M" index adjustment.
+func parseRelAdjust(rest string) (adjust int, ok bool, tail string, err error) {
+	if len(rest) == 0 || (rest[0] != '+' && rest[0] != '-') {
+		return 0, false, rest, nil
+	}
+
+	j := 1
+	for j < len(rest) && rest[j] >= '0' && rest[j] <= '9' {
+		j++
+	}
+
+	if j == 1 {
+		return 0, false, rest, fmt.Errorf("jsonptr: invalid index adjustment in relative pointer suffix %q", rest)
+	}
+
+	n, err := strconv.Atoi(rest[:j])
+	if err != nil {
+		return 0, false, rest, fmt.Errorf("jsonptr: invalid index adjustment %q: %w", rest[:j], err)
+	}
+
+	return n, true, rest[j:], nil
+}
+
+// ptrTokens splits ptr into its unescaped tokens. The whole-document pointer "" yields nil.
+func ptrTokens(ptr Ptr) []PtrToken {
+	if len(ptr) == 0 {
+		return nil
+	}
+
+	parts := strings.Split(ptr, "/")[1:]
+	tokens := make([]PtrToken, len(parts))
+	for i, p := range parts {
+		tokens[i] = Unescape(p)
+	}
+
+	return tokens
+}
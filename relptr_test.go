@@ -0,0 +1,92 @@
+package jsonptr
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEvalRelative(t *testing.T) {
+	obj := &Obj{}
+	if err := json.Unmarshal([]byte(`{"foo":[{"a":1},{"a":2}],"highly":{"nested":{"objects":true}}}`), &obj); err != nil {
+		t.Fatal(err)
+	}
+
+	arr := MustEval(obj, "/foo").(*Arr)
+	current := arr.Get(1).(*Obj)
+	currentPtr := Ptr("/foo/1")
+
+	// "0" - the current value itself
+	if v, err := EvalRelative(obj, current, currentPtr, "0"); err != nil || v.(*Obj) != current {
+		t.Fatalf("expected current itself, got %v, err %v", v, err)
+	}
+
+	// "1" - one level up, the containing array
+	if v, err := EvalRelative(obj, current, currentPtr, "1"); err != nil || v.(*Arr).Len() != 2 {
+		t.Fatalf("expected array of len 2, got %v, err %v", v, err)
+	}
+
+	// "0#" - the index that referenced current
+	if v, err := EvalRelative(obj, current, currentPtr, "0#"); err != nil || v.Float64() != 1 {
+		t.Fatalf("expected 1, got %v, err %v", v, err)
+	}
+
+	// "1#" - the key that referenced the containing array
+	if v, err := EvalRelative(obj, current, currentPtr, "1#"); err != nil || v.String() != "foo" {
+		t.Fatalf("expected foo, got %v, err %v", v, err)
+	}
+
+	// "0-1" - the preceding sibling in the array
+	if v, err := EvalRelative(obj, current, currentPtr, "0-1"); err != nil || v.(*Obj).Len() != 1 {
+		t.Fatalf("expected the first array element, got %v, err %v", v, err)
+	} else if f, _ := v.(*Obj).Get("a"); f.Float64() != 1 {
+		t.Fatalf("expected a=1, got %v", f)
+	}
+
+	// "2/highly/nested/objects" - walk up to the root, then descend
+	if v, err := EvalRelative(obj, current, currentPtr, "2/highly/nested/objects"); err != nil || v.Bool() != true {
+		t.Fatalf("expected true, got %v, err %v", v, err)
+	}
+}
+
+func TestEvalRelativeErrors(t *testing.T) {
+	obj := &Obj{}
+	if err := json.Unmarshal([]byte(`{"foo":[{"a":1},{"a":2}],"highly":{"nested":{"objects":true}}}`), &obj); err != nil {
+		t.Fatal(err)
+	}
+
+	arr := MustEval(obj, "/foo").(*Arr)
+	current := arr.Get(0).(*Obj)
+	currentPtr := Ptr("/foo/0")
+
+	if _, err := EvalRelative(obj, current, currentPtr, "5"); err == nil {
+		t.Fatal("expected error walking above the root")
+	}
+
+	nested := MustEval(obj, "/highly/nested")
+	if _, err := EvalRelative(obj, nested.(*Obj), "/highly/nested/objects", "0+1"); err == nil {
+		t.Fatal("expected error applying +/- to a non-array parent")
+	}
+
+	if _, err := EvalRelative(obj, current, currentPtr, "0#/foo"); err == nil {
+		t.Fatal("expected error combining # with a pointer suffix")
+	}
+}
+
+func TestPtrParentAndJoin(t *testing.T) {
+	parent, last, ok := PtrParent("/a/b~1c")
+	if !ok || parent != "/a" || last != "b/c" {
+		t.Fatalf("unexpected result: %q %q %v", parent, last, ok)
+	}
+
+	if _, _, ok := PtrParent(""); ok {
+		t.Fatal("expected no parent for the whole-document pointer")
+	}
+
+	if _, _, ok := PtrParent("abc"); ok {
+		t.Fatal("expected no parent for a malformed pointer without a leading slash")
+	}
+
+	if joined := PtrJoin("/a", "b/c", "0"); joined != "/a/b~1c/0" {
+		t.Fatalf("unexpected joined pointer: %q", joined)
+	}
+}
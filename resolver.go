@@ -0,0 +1,83 @@
+package jsonptr
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A Resolver holds a set of JSON documents registered under a base URI and follows "$ref" chains between them,
+// so that {"$ref": "other.json#/a/b"} can be resolved across registered documents. The zero value is ready to
+// use; register the primary document under the empty base URI "" to resolve bare fragments like "#/a/b".
+type Resolver struct {
+	docs map[string]ObjOrArr
+}
+
+// Register associates doc with baseURI, so that later $ref values referencing baseURI resolve against it.
+func (r *Resolver) Register(baseURI string, doc ObjOrArr) {
+	if r.docs == nil {
+		r.docs = make(map[string]ObjOrArr)
+	}
+
+	r.docs[baseURI] = doc
+}
+
+// Resolve follows ref, a URI such as "#/a/b" or "other.json#/a/b", across the registered documents, transparently
+// following any "$ref" members it lands on until a non-$ref value is reached.
+func (r *Resolver) Resolve(ref string) (Value, error) {
+	return r.resolve(ref, make(map[string]bool))
+}
+
+func (r *Resolver) resolve(ref string, visited map[string]bool) (Value, error) {
+	base, frag := splitRef(ref)
+
+	doc, ok := r.docs[base]
+	if !ok {
+		return nil, fmt.Errorf("jsonptr: no document registered for %q", base)
+	}
+
+	key := base + frag
+	if visited[key] {
+		return nil, fmt.Errorf("jsonptr: cycle detected resolving %q", ref)
+	}
+
+	visited[key] = true
+
+	val, err := EvalURI(doc, frag)
+	if err != nil {
+		return nil, err
+	}
+
+	if obj, ok := val.(*Obj); ok {
+		if refVal, ok := obj.Get("$ref"); ok {
+			next, ok := refVal.(String)
+			if !ok {
+				return nil, fmt.Errorf("jsonptr: $ref at %q is not a string", ref)
+			}
+
+			return r.resolve(resolveRef(base, string(next)), visited)
+		}
+	}
+
+	return val, nil
+}
+
+// splitRef splits ref into its base URI and fragment; frag includes the leading "#", if any.
+func splitRef(ref string) (base, frag string) {
+	idx := strings.IndexByte(ref, '#')
+	if idx < 0 {
+		return ref, ""
+	}
+
+	return ref[:idx], ref[idx:]
+}
+
+// resolveRef combines a $ref value with the base URI of the document it was found in: a fragment-only ref
+// ("#/a/b") stays within currentBase, while a ref with its own base ("other.json#/a/b") switches to it.
+func resolveRef(currentBase, ref string) string {
+	base, frag := splitRef(ref)
+	if base == "" {
+		return currentBase + frag
+	}
+
+	return ref
+}
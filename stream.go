@@ -0,0 +1,156 @@
+package jsonptr
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// EvalStream resolves ptr against the JSON document read from r without materialising the whole document into
+// Obj/Arr. Only the value addressed by ptr (and the path leading to it) is ever decoded; sibling members and
+// elements are skipped token-by-token. This makes it feasible to pick a single field out of a multi-hundred-
+// megabyte JSON document.
+func EvalStream(r io.Reader, ptr Ptr) (Value, error) {
+	raw, err := EvalStreamRaw(r, ptr)
+	if err != nil {
+		return nil, err
+	}
+
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, err
+	}
+
+	return ValueOf(v), nil
+}
+
+// EvalStreamRaw resolves ptr against the JSON document read from r, the same way EvalStream does, but returns the
+// addressed value as a raw, un-decoded json.RawMessage.
+func EvalStreamRaw(r io.Reader, ptr Ptr) (json.RawMessage, error) {
+	if len(ptr) > 0 && !strings.HasPrefix(ptr, "/") {
+		return nil, fmt.Errorf("invalid json pointer: %s", ptr)
+	}
+
+	dec := json.NewDecoder(r)
+	return evalStreamTokens(dec, ptrTokens(ptr))
+}
+
+// evalStreamTokens reads the next JSON value off dec. If tokens is empty, that value is the target and is
+// materialised as-is. Otherwise the value must be an object or array, which is descended into looking for
+// tokens[0].
+func evalStreamTokens(dec *json.Decoder, tokens []PtrToken) (json.RawMessage, error) {
+	if len(tokens) == 0 {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return nil, err
+		}
+
+		return raw, nil
+	}
+
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	delim, ok := tok.(json.Delim)
+	if !ok {
+		return nil, fmt.Errorf("key '%s' not addressable: found a %T", tokens[0], tok)
+	}
+
+	switch delim {
+	case '{':
+		return evalStreamObject(dec, tokens)
+	case '[':
+		return evalStreamArray(dec, tokens)
+	default:
+		return nil, fmt.Errorf("key '%s' not addressable: found closing '%s'", tokens[0], string(delim))
+	}
+}
+
+func evalStreamObject(dec *json.Decoder, tokens []PtrToken) (json.RawMessage, error) {
+	target := tokens[0]
+
+	for dec.More() {
+		keyTok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		key, _ := keyTok.(string)
+		if key == target {
+			return evalStreamTokens(dec, tokens[1:])
+		}
+
+		if err := skipStreamValue(dec); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := dec.Token(); err != nil { // consume the closing '}'
+		return nil, err
+	}
+
+	return nil, fmt.Errorf("key '%s' not found", target)
+}
+
+func evalStreamArray(dec *json.Decoder, tokens []PtrToken) (json.RawMessage, error) {
+	idx, err := strconv.Atoi(tokens[0])
+	if err != nil {
+		return nil, fmt.Errorf("expected integer index, got '%s'", tokens[0])
+	}
+
+	if idx < 0 {
+		return nil, fmt.Errorf("index out of bounds: %d", idx)
+	}
+
+	for i := 0; dec.More(); i++ {
+		if i == idx {
+			return evalStreamTokens(dec, tokens[1:])
+		}
+
+		if err := skipStreamValue(dec); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := dec.Token(); err != nil { // consume the closing ']'
+		return nil, err
+	}
+
+	return nil, fmt.Errorf("index out of bounds: %d", idx)
+}
+
+// skipStreamValue consumes and discards the next JSON value from dec without materialising it, tracking
+// brace/bracket depth to jump past nested objects and arrays.
+func skipStreamValue(dec *json.Decoder) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	delim, ok := tok.(json.Delim)
+	if !ok || (delim != '{' && delim != '[') {
+		return nil
+	}
+
+	for depth := 1; depth > 0; {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		if d, ok := tok.(json.Delim); ok {
+			switch d {
+			case '{', '[':
+				depth++
+			case '}', ']':
+				depth--
+			}
+		}
+	}
+
+	return nil
+}
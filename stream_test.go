@@ -0,0 +1,76 @@
+package jsonptr
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestEvalStream(t *testing.T) {
+	if v, err := EvalStream(strings.NewReader(testJSON2), "/details/name"); err != nil || v.String() != "hello" {
+		t.Fatalf("expected hello, got %v, err %v", v, err)
+	}
+
+	if v, err := EvalStream(strings.NewReader(testJSON2), "/details/nested/list/1"); err != nil || v.String() != "2" {
+		t.Fatalf("expected 2, got %v, err %v", v, err)
+	}
+
+	if v, err := EvalStream(strings.NewReader(testJSON2), ""); err != nil || v.(*Obj).Len() != 2 {
+		t.Fatalf("expected the whole document, got %v, err %v", v, err)
+	}
+
+	if _, err := EvalStream(strings.NewReader(testJSON2), "/details/missing"); err == nil {
+		t.Fatal("expected an error for a missing key")
+	}
+
+	if _, err := EvalStream(strings.NewReader(testJSON2), "/details/nested/list/9"); err == nil {
+		t.Fatal("expected an error for an out-of-bounds index")
+	}
+}
+
+func TestEvalStreamRaw(t *testing.T) {
+	raw, err := EvalStreamRaw(strings.NewReader(testJSON2), "/details/nested/even")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(raw) != `"more"` {
+		t.Fatalf(`expected "more", got %s`, raw)
+	}
+}
+
+func TestEvalStreamAgreesWithEval(t *testing.T) {
+	var obj Obj
+	if err := json.Unmarshal([]byte(testJSON2), &obj); err != nil {
+		t.Fatal(err)
+	}
+
+	want := MustEval(&obj, "/details/nested/even").String()
+	got, err := EvalStream(strings.NewReader(testJSON2), "/details/nested/even")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.String() != want {
+		t.Fatalf("expected %q, got %q", want, got.String())
+	}
+}
+
+func TestEvalStreamAgreesWithEvalEscapedKey(t *testing.T) {
+	const doc = `{"a~0b":"hit"}`
+
+	var obj Obj
+	if err := json.Unmarshal([]byte(doc), &obj); err != nil {
+		t.Fatal(err)
+	}
+
+	want := MustEval(&obj, "/a~00b").String()
+	got, err := EvalStream(strings.NewReader(doc), "/a~00b")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.String() != want {
+		t.Fatalf("expected %q, got %q", want, got.String())
+	}
+}
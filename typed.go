@@ -0,0 +1,81 @@
+package jsonptr
+
+// EvalString resolves ptr like Eval, additionally asserting that the result is a String. found is false, with a
+// nil error, if ptr resolved but to a value of a different type; err is non-nil if ptr did not resolve at all.
+func EvalString(objOrArr ObjOrArr, ptr Ptr) (val string, found bool, err error) {
+	v, err := Eval(objOrArr, ptr)
+	if err != nil {
+		return "", false, err
+	}
+
+	s, ok := v.(String)
+	if !ok {
+		return "", false, nil
+	}
+
+	return string(s), true, nil
+}
+
+// EvalFloat64 resolves ptr like Eval, additionally asserting that the result is a Number. found is false, with a
+// nil error, if ptr resolved but to a value of a different type; err is non-nil if ptr did not resolve at all.
+func EvalFloat64(objOrArr ObjOrArr, ptr Ptr) (val float64, found bool, err error) {
+	v, err := Eval(objOrArr, ptr)
+	if err != nil {
+		return 0, false, err
+	}
+
+	n, ok := v.(Number)
+	if !ok {
+		return 0, false, nil
+	}
+
+	return float64(n), true, nil
+}
+
+// EvalBool resolves ptr like Eval, additionally asserting that the result is a Bool. found is false, with a nil
+// error, if ptr resolved but to a value of a different type; err is non-nil if ptr did not resolve at all.
+func EvalBool(objOrArr ObjOrArr, ptr Ptr) (val bool, found bool, err error) {
+	v, err := Eval(objOrArr, ptr)
+	if err != nil {
+		return false, false, err
+	}
+
+	b, ok := v.(Bool)
+	if !ok {
+		return false, false, nil
+	}
+
+	return bool(b), true, nil
+}
+
+// EvalObj resolves ptr like Eval, additionally asserting that the result is an *Obj. found is false, with a nil
+// error, if ptr resolved but to a value of a different type; err is non-nil if ptr did not resolve at all.
+func EvalObj(objOrArr ObjOrArr, ptr Ptr) (val *Obj, found bool, err error) {
+	v, err := Eval(objOrArr, ptr)
+	if err != nil {
+		return nil, false, err
+	}
+
+	o, ok := v.(*Obj)
+	if !ok {
+		return nil, false, nil
+	}
+
+	return o, true, nil
+}
+
+// EvalArr resolves ptr like Eval, additionally asserting that the result is an *Arr. found is false, with a nil
+// error, if ptr resolved but to a value of a different type; err is non-nil if ptr did not resolve at all.
+func EvalArr(objOrArr ObjOrArr, ptr Ptr) (val *Arr, found bool, err error) {
+	v, err := Eval(objOrArr, ptr)
+	if err != nil {
+		return nil, false, err
+	}
+
+	a, ok := v.(*Arr)
+	if !ok {
+		return nil, false, nil
+	}
+
+	return a, true, nil
+}
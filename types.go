@@ -1,6 +1,7 @@
 package jsonptr
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"log/slog"
@@ -68,13 +69,23 @@ func (n Number) Float64() float64 {
 	return float64(n)
 }
 
-type Obj map[string]Value
+type objEntry struct {
+	Key string
+	Val Value
+}
+
+// Obj is a JSON object that preserves the insertion order of its members, so that an Obj decoded via
+// UnmarshalJSON, mutated, and re-encoded via MarshalJSON round-trips with the same key order it was read with.
+type Obj struct {
+	index   map[string]int
+	entries []objEntry
+}
 
-func (Obj) value() {}
+func (o *Obj) value() {}
 
-func (Obj) objOrArr() {}
+func (o *Obj) objOrArr() {}
 
-func (o Obj) String() string {
+func (o *Obj) String() string {
 	buf, err := json.MarshalIndent(o, "", "  ")
 	if err != nil {
 		return fmt.Sprintf("%#v", o)
@@ -83,26 +94,216 @@ func (o Obj) String() string {
 	return string(buf)
 }
 
-func (o Obj) Bool() bool {
+func (o *Obj) Bool() bool {
 	return o != nil
 }
 
-func (o Obj) Float64() float64 {
+func (o *Obj) Float64() float64 {
 	return 0
 }
 
-func (o Obj) UnmarshalJSON(bytes []byte) error {
-	var tmp map[string]any
-	if err := json.Unmarshal(bytes, &tmp); err != nil {
+func (o *Obj) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+
+	for i, e := range o.entries {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		key, err := json.Marshal(e.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(key)
+		buf.WriteByte(':')
+
+		val, err := json.Marshal(e.Val)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(val)
+	}
+
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes o via a streaming json.Decoder, so that the resulting key order matches the order the
+// members appeared in the input rather than Go's randomised map iteration order. Nested objects and arrays are
+// decoded the same way, recursively, so order is preserved at every depth.
+func (o *Obj) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	tok, err := dec.Token()
+	if err != nil {
 		return err
 	}
 
-	clear(o)
-	for k, v := range tmp {
-		o[k] = ValueOf(v)
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("jsonptr: expected a json object, got %v", tok)
 	}
 
-	return nil
+	o.index = nil
+	o.entries = nil
+
+	for dec.More() {
+		keyTok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		key, _ := keyTok.(string)
+
+		val, err := decodeValue(dec)
+		if err != nil {
+			return err
+		}
+
+		o.Put(key, val)
+	}
+
+	_, err = dec.Token() // consume the closing '}'
+	return err
+}
+
+// decodeValue reads the next JSON value off dec. Objects and arrays are decoded recursively via decodeObj and
+// decodeArr so that their member/element order is preserved; everything else is handed to ValueOf.
+func decodeValue(dec *json.Decoder) (Value, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	if delim, ok := tok.(json.Delim); ok {
+		switch delim {
+		case '{':
+			return decodeObj(dec)
+		case '[':
+			return decodeArr(dec)
+		default:
+			return nil, fmt.Errorf("jsonptr: unexpected delimiter %q", string(delim))
+		}
+	}
+
+	return ValueOf(tok), nil
+}
+
+// decodeObj reads object members off dec, up to and including the closing '}', preserving order.
+func decodeObj(dec *json.Decoder) (*Obj, error) {
+	obj := &Obj{}
+
+	for dec.More() {
+		keyTok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		key, _ := keyTok.(string)
+
+		val, err := decodeValue(dec)
+		if err != nil {
+			return nil, err
+		}
+
+		obj.Put(key, val)
+	}
+
+	if _, err := dec.Token(); err != nil { // consume the closing '}'
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// decodeArr reads array elements off dec, up to and including the closing ']', preserving order.
+func decodeArr(dec *json.Decoder) (*Arr, error) {
+	arr := &Arr{}
+
+	for dec.More() {
+		val, err := decodeValue(dec)
+		if err != nil {
+			return nil, err
+		}
+
+		arr.Append(val)
+	}
+
+	if _, err := dec.Token(); err != nil { // consume the closing ']'
+		return nil, err
+	}
+
+	return arr, nil
+}
+
+// Get returns the value addressed by key and whether it was present.
+func (o *Obj) Get(key string) (Value, bool) {
+	i, ok := o.index[key]
+	if !ok {
+		return nil, false
+	}
+
+	return o.entries[i].Val, true
+}
+
+// Put inserts or replaces the value addressed by key. A new key is appended after the existing members; an
+// existing key keeps its original position.
+func (o *Obj) Put(key string, value Value) {
+	if i, ok := o.index[key]; ok {
+		o.entries[i].Val = value
+		return
+	}
+
+	if o.index == nil {
+		o.index = make(map[string]int)
+	}
+
+	o.index[key] = len(o.entries)
+	o.entries = append(o.entries, objEntry{Key: key, Val: value})
+}
+
+// Delete removes the member addressed by key, if present.
+func (o *Obj) Delete(key string) {
+	i, ok := o.index[key]
+	if !ok {
+		return
+	}
+
+	o.entries = append(o.entries[:i], o.entries[i+1:]...)
+	delete(o.index, key)
+
+	for k, idx := range o.index {
+		if idx > i {
+			o.index[k] = idx - 1
+		}
+	}
+}
+
+// Len returns the number of members in o.
+func (o *Obj) Len() int {
+	return len(o.entries)
+}
+
+// Keys returns the object's member names in insertion order.
+func (o *Obj) Keys() []string {
+	keys := make([]string, len(o.entries))
+	for i, e := range o.entries {
+		keys[i] = e.Key
+	}
+
+	return keys
+}
+
+// Range calls fn for each member in insertion order, stopping early if fn returns false.
+func (o *Obj) Range(fn func(key string, val Value) bool) {
+	for _, e := range o.entries {
+		if !fn(e.Key, e.Val) {
+			return
+		}
+	}
 }
 
 type Arr struct {
@@ -146,19 +347,33 @@ func (a *Arr) MarshalJSON() ([]byte, error) {
 	return json.Marshal(a.slice)
 }
 
-func (a *Arr) UnmarshalJSON(bytes []byte) error {
-	var tmp []any
-	if err := json.Unmarshal(bytes, &tmp); err != nil {
+// UnmarshalJSON decodes a via a streaming json.Decoder so that nested objects preserve their member order the
+// same way Obj.UnmarshalJSON does.
+func (a *Arr) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	tok, err := dec.Token()
+	if err != nil {
 		return err
 	}
 
-	a.slice = a.slice[:0]
-	a.slice = make([]Value, 0, len(tmp))
-	for _, v := range tmp {
-		a.slice = append(a.slice, ValueOf(v))
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("jsonptr: expected a json array, got %v", tok)
 	}
 
-	return nil
+	a.slice = nil
+
+	for dec.More() {
+		val, err := decodeValue(dec)
+		if err != nil {
+			return err
+		}
+
+		a.slice = append(a.slice, val)
+	}
+
+	_, err = dec.Token() // consume the closing ']'
+	return err
 }
 
 func (a *Arr) objOrArr() {}
@@ -174,6 +389,13 @@ func (a *Arr) SetAt(idx int, v Value) {
 	a.slice[idx] = v
 }
 
+// RemoveAt removes and returns the element at idx, shifting subsequent elements down.
+func (a *Arr) RemoveAt(idx int) Value {
+	v := a.slice[idx]
+	a.slice = append(a.slice[:idx], a.slice[idx+1:]...)
+	return v
+}
+
 func (a *Arr) Get(idx int) Value {
 	return a.slice[idx]
 }
@@ -227,9 +449,11 @@ func ValueOf(from any) Value {
 	case Value:
 		return t
 	case map[string]any:
-		obj := make(Obj)
+		// native Go maps carry no order of their own, so the resulting member order is arbitrary; only
+		// decoding via UnmarshalJSON preserves the order of the original input.
+		obj := &Obj{}
 		for k, v := range t {
-			obj[k] = ValueOf(v)
+			obj.Put(k, ValueOf(v))
 		}
 		return obj
 	case []any:
@@ -0,0 +1,60 @@
+package jsonptr
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// EscapeURI takes any string and returns a URI-fragment-safe token: ~0/~1 escaping per RFC 6901, followed by
+// percent-encoding per RFC 3986.
+func EscapeURI(str string) PtrToken {
+	return url.PathEscape(Escape(str))
+}
+
+// UnescapeURI takes a URI-fragment token and returns the original string: percent-decoding per RFC 3986,
+// followed by ~0/~1 unescaping per RFC 6901. Returns an error if str contains invalid percent-encoding.
+func UnescapeURI(str PtrToken) (string, error) {
+	unescaped, err := url.PathUnescape(str)
+	if err != nil {
+		return "", fmt.Errorf("invalid percent-encoding in %q: %w", str, err)
+	}
+
+	return Unescape(unescaped), nil
+}
+
+// EvalURI resolves uri, a JSON Pointer in its URI-fragment form (e.g. "#/foo/0", with tokens percent-encoded per
+// RFC 3986), against objOrArr. A leading "#" is optional and is stripped if present.
+func EvalURI(objOrArr ObjOrArr, uri string) (Value, error) {
+	ptr, err := uriToPtr(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	return Eval(objOrArr, ptr)
+}
+
+// uriToPtr converts a URI-fragment pointer into an equivalent plain Ptr.
+func uriToPtr(uri string) (Ptr, error) {
+	uri = strings.TrimPrefix(uri, "#")
+	if len(uri) == 0 {
+		return "", nil
+	}
+
+	if !strings.HasPrefix(uri, "/") {
+		return "", fmt.Errorf("invalid uri fragment json pointer: %s", uri)
+	}
+
+	parts := strings.Split(uri, "/")[1:]
+	tokens := make([]PtrToken, len(parts))
+	for i, p := range parts {
+		tok, err := UnescapeURI(p)
+		if err != nil {
+			return "", err
+		}
+
+		tokens[i] = tok
+	}
+
+	return PtrJoin("", tokens...), nil
+}
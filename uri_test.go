@@ -0,0 +1,87 @@
+package jsonptr
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEscapeUnescapeURI(t *testing.T) {
+	const raw = "a/b~c d"
+
+	esc := EscapeURI(raw)
+	if esc == raw {
+		t.Fatalf("expected %q to be escaped", raw)
+	}
+
+	if got, err := UnescapeURI(esc); err != nil || got != raw {
+		t.Fatalf("expected %q, got %q, err %v", raw, got, err)
+	}
+}
+
+func TestUnescapeURIInvalidPercentEncoding(t *testing.T) {
+	if _, err := UnescapeURI("a%2"); err == nil {
+		t.Fatal("expected an error for truncated percent-encoding")
+	}
+}
+
+func TestEvalURI(t *testing.T) {
+	obj := &Obj{}
+	if err := json.Unmarshal([]byte(`{"a/b":["x","y"]," c":1}`), &obj); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, err := EvalURI(obj, "#/"+EscapeURI("a/b")+"/0"); err != nil || v.String() != "x" {
+		t.Fatalf("expected x, got %v, err %v", v, err)
+	}
+
+	if v, err := EvalURI(obj, "/"+EscapeURI(" c")); err != nil || v.Float64() != 1 {
+		t.Fatalf("expected 1, got %v, err %v", v, err)
+	}
+
+	if v, err := EvalURI(obj, "#"); err != nil || v.(*Obj).Len() != 2 {
+		t.Fatalf("expected the whole document, got %v, err %v", v, err)
+	}
+}
+
+func TestResolver(t *testing.T) {
+	primary := &Obj{}
+	if err := json.Unmarshal([]byte(`{"a":{"$ref":"#/b"},"b":42}`), &primary); err != nil {
+		t.Fatal(err)
+	}
+
+	other := &Obj{}
+	if err := json.Unmarshal([]byte(`{"c":{"$ref":"primary.json#/b"}}`), &other); err != nil {
+		t.Fatal(err)
+	}
+
+	var resolver Resolver
+	resolver.Register("", primary)
+	resolver.Register("primary.json", primary)
+	resolver.Register("other.json", other)
+
+	if v, err := resolver.Resolve("#/a"); err != nil || v.Float64() != 42 {
+		t.Fatalf("expected 42, got %v, err %v", v, err)
+	}
+
+	if v, err := resolver.Resolve("other.json#/c"); err != nil || v.Float64() != 42 {
+		t.Fatalf("expected 42, got %v, err %v", v, err)
+	}
+
+	if _, err := resolver.Resolve("missing.json#/x"); err == nil {
+		t.Fatal("expected an error for an unregistered document")
+	}
+}
+
+func TestResolverDetectsCycles(t *testing.T) {
+	cyclic := &Obj{}
+	if err := json.Unmarshal([]byte(`{"a":{"$ref":"#/b"},"b":{"$ref":"#/a"}}`), &cyclic); err != nil {
+		t.Fatal(err)
+	}
+
+	var resolver Resolver
+	resolver.Register("", cyclic)
+
+	if _, err := resolver.Resolve("#/a"); err == nil {
+		t.Fatal("expected a cycle detection error")
+	}
+}